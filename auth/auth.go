@@ -0,0 +1,61 @@
+// Package auth provides API-key authentication and per-key rate limiting
+// for the write endpoints of the egg-price-tracker API.
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/11522514/egg-price-tracker/db"
+)
+
+type contextKey string
+
+const keyIDContextKey contextKey = "api_key_id"
+
+// HashKey returns the stored representation of a raw API key. Keys are
+// hashed at rest so a DB leak doesn't expose usable credentials.
+func HashKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// RequireAPIKey authenticates a request via "Authorization: Bearer <key>",
+// rejecting it if the header is missing or the key is unknown/revoked. The
+// matched key's ID is stashed in the request context so handlers can record
+// created_by_key_id.
+func RequireAPIKey(database *db.DB) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if !strings.HasPrefix(header, "Bearer ") {
+				http.Error(w, "Missing API key", http.StatusUnauthorized)
+				return
+			}
+
+			raw := strings.TrimPrefix(header, "Bearer ")
+			keyID, err := database.LookupAPIKey(HashKey(raw))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if keyID == 0 {
+				http.Error(w, "Invalid API key", http.StatusUnauthorized)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), keyIDContextKey, keyID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// KeyIDFromContext returns the authenticated key's ID, or 0 if the request
+// wasn't authenticated.
+func KeyIDFromContext(ctx context.Context) int {
+	id, _ := ctx.Value(keyIDContextKey).(int)
+	return id
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter enforces a per-key token-bucket rate limit so a misbehaving
+// scheduled scraper can't accidentally hammer the DB.
+type RateLimiter struct {
+	rps   float64
+	burst float64
+
+	mu      sync.Mutex
+	buckets map[int]*bucket
+}
+
+type bucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// NewRateLimiter builds a RateLimiter allowing up to rps requests per second
+// per key, with a burst of up to rps requests.
+func NewRateLimiter(rps float64) *RateLimiter {
+	return &RateLimiter{rps: rps, burst: rps, buckets: make(map[int]*bucket)}
+}
+
+func (rl *RateLimiter) allow(keyID int) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	b, ok := rl.buckets[keyID]
+	if !ok {
+		b = &bucket{tokens: rl.burst, lastSeen: now}
+		rl.buckets[keyID] = b
+	}
+
+	elapsed := now.Sub(b.lastSeen).Seconds()
+	b.tokens = math.Min(rl.burst, b.tokens+elapsed*rl.rps)
+	b.lastSeen = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Middleware rejects requests once the authenticated key has exceeded its
+// rate limit. Must run after RequireAPIKey so the key ID is in context.
+func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !rl.allow(KeyIDFromContext(r.Context())) {
+			http.Error(w, "Rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
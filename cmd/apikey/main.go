@@ -0,0 +1,42 @@
+// Command apikey generates a new API key for the write endpoints and stores
+// its hash in the api_keys table.
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/11522514/egg-price-tracker/auth"
+	"github.com/11522514/egg-price-tracker/db"
+)
+
+func main() {
+	label := flag.String("label", "", "human-readable label for this key (required)")
+	dbURL := flag.String("db-url", "", "postgres connection string (required)")
+	flag.Parse()
+
+	if *label == "" || *dbURL == "" {
+		log.Fatal("usage: apikey -label <label> -db-url <postgres connection string>")
+	}
+
+	database, err := db.New(*dbURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer database.Close()
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		log.Fatal(err)
+	}
+	key := hex.EncodeToString(raw)
+
+	if err := database.CreateAPIKey(*label, auth.HashKey(key)); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("API key for %q: %s\n", *label, key)
+}
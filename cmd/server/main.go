@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/11522514/egg-price-tracker/auth"
+	"github.com/11522514/egg-price-tracker/db"
+	"github.com/11522514/egg-price-tracker/handlers"
+	"github.com/11522514/egg-price-tracker/router"
+	"github.com/11522514/egg-price-tracker/scheduler"
+)
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func main() {
+	dbHost := getEnv("DB_HOST", "localhost")
+	dbPort := getEnv("DB_PORT", "5432")
+	dbUser := getEnv("DB_USER", "postgres")
+	dbPassword := getEnv("DB_PASSWORD", "password")
+	dbName := getEnv("DB_NAME", "egg_tracker")
+
+	dbURL := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		dbHost, dbPort, dbUser, dbPassword, dbName)
+
+	database, err := db.New(dbURL)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	defer database.Close()
+	log.Println("Database connection established, migrations applied")
+
+	sourceRegistry, err := scheduler.NewRegistry(getEnv("SOURCES_CONFIG", "sources.json"))
+	if err != nil {
+		log.Fatal("Failed to load source registry:", err)
+	}
+
+	sched := scheduler.New(sourceRegistry, database.UpsertFetchedPrices, 1*time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sched.Start(ctx)
+
+	rateLimitRPS, err := strconv.ParseFloat(getEnv("RATE_LIMIT_RPS", "5"), 64)
+	if err != nil {
+		log.Fatal("Invalid RATE_LIMIT_RPS:", err)
+	}
+	rateLimiter := auth.NewRateLimiter(rateLimitRPS)
+
+	corsOrigins := strings.Split(getEnv("CORS_ALLOWED_ORIGINS", "http://localhost:3000"), ",")
+
+	server := handlers.NewServer(database, sourceRegistry, rateLimiter)
+	mux := router.New(server, corsOrigins)
+
+	port := getEnv("PORT", "8080")
+	log.Printf("Server starting on port %s", port)
+	log.Fatal(http.ListenAndServe(":"+port, mux))
+}
@@ -0,0 +1,33 @@
+package db
+
+import "database/sql"
+
+// LookupAPIKey returns the id of the non-revoked key matching keyHash, or 0
+// if no such key exists.
+func (d *DB) LookupAPIKey(keyHash string) (int, error) {
+	var id int
+	err := d.QueryRow(`SELECT id FROM api_keys WHERE key_hash = $1 AND revoked_at IS NULL`, keyHash).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// CreateAPIKey stores a new key under label, keyed by its hash. The raw key
+// itself is never persisted.
+func (d *DB) CreateAPIKey(label, keyHash string) error {
+	_, err := d.Exec(`INSERT INTO api_keys (label, key_hash) VALUES ($1, $2)`, label, keyHash)
+	return err
+}
+
+// nullableKeyID turns an unauthenticated (zero) key ID into a SQL NULL so it
+// doesn't trip the created_by_key_id foreign key.
+func nullableKeyID(keyID int) interface{} {
+	if keyID == 0 {
+		return nil
+	}
+	return keyID
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"context"
+
+	"github.com/11522514/egg-price-tracker/models"
+)
+
+// StreamPrices calls fn for every row in egg_prices, ordered by date,
+// without buffering the full result set in memory — used by the export
+// endpoint so multi-year pulls don't blow up server memory.
+func (d *DB) StreamPrices(ctx context.Context, fn func(models.EggPrice) error) error {
+	rows, err := d.QueryContext(ctx, `SELECT id, date, location, price_per_dozen, source, created_at
+		FROM egg_prices ORDER BY date`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p models.EggPrice
+		if err := rows.Scan(&p.ID, &p.Date, &p.Location, &p.PricePerDozen, &p.Source, &p.CreatedAt); err != nil {
+			return err
+		}
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
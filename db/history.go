@@ -0,0 +1,22 @@
+package db
+
+// GetPriceHistory returns a location's price_per_dozen values ordered
+// oldest-first, the shape the forecast package expects.
+func (d *DB) GetPriceHistory(location string) ([]float64, error) {
+	rows, err := d.Query(`SELECT price_per_dozen FROM egg_prices WHERE location = $1 ORDER BY date`, location)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prices []float64
+	for rows.Next() {
+		var p float64
+		if err := rows.Scan(&p); err != nil {
+			return nil, err
+		}
+		prices = append(prices, p)
+	}
+
+	return prices, rows.Err()
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/11522514/egg-price-tracker/models"
+)
+
+// ImportPrices inserts each row inside a single transaction, using a
+// savepoint per row so a bad row is skipped and recorded rather than
+// aborting rows that already succeeded. createdByKeyID is recorded against
+// every inserted row. Errors are reported against each row's original
+// RowNum, not its index in rows, since earlier rows may already have been
+// dropped during validation.
+func (d *DB) ImportPrices(ctx context.Context, rows []models.ImportRow, createdByKeyID int) (models.ImportResult, error) {
+	var result models.ImportResult
+
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return result, err
+	}
+
+	const (
+		insert         = `INSERT INTO egg_prices (date, location, price_per_dozen, source, created_by_key_id) VALUES ($1, $2, $3, $4, $5)`
+		savepoint      = `SAVEPOINT row_import`
+		rollbackToSave = `ROLLBACK TO SAVEPOINT row_import`
+		releaseSave    = `RELEASE SAVEPOINT row_import`
+	)
+
+	const zoneExists = `SELECT EXISTS (SELECT 1 FROM zones WHERE code = $1)`
+
+	for _, row := range rows {
+		if _, err := tx.ExecContext(ctx, savepoint); err != nil {
+			tx.Rollback()
+			return result, err
+		}
+
+		var exists bool
+		if err := tx.QueryRowContext(ctx, zoneExists, row.Location).Scan(&exists); err != nil {
+			tx.Rollback()
+			return result, err
+		}
+		if !exists {
+			result.Skipped++
+			result.Errors = append(result.Errors, fmt.Sprintf("row %d: unknown zone %q", row.RowNum, row.Location))
+			if _, rbErr := tx.ExecContext(ctx, rollbackToSave); rbErr != nil {
+				tx.Rollback()
+				return result, rbErr
+			}
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, insert, row.Date, row.Location, row.PricePerDozen, row.Source, nullableKeyID(createdByKeyID)); err != nil {
+			result.Skipped++
+			result.Errors = append(result.Errors, fmt.Sprintf("row %d: %v", row.RowNum, err))
+			if _, rbErr := tx.ExecContext(ctx, rollbackToSave); rbErr != nil {
+				tx.Rollback()
+				return result, rbErr
+			}
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, releaseSave); err != nil {
+			tx.Rollback()
+			return result, err
+		}
+		result.Inserted++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/11522514/egg-price-tracker/models"
+	"github.com/11522514/egg-price-tracker/scheduler"
+)
+
+// AddPrice inserts a single price, recording which API key (if any) created
+// it, and returns it with its assigned ID.
+func (d *DB) AddPrice(p models.EggPrice, createdByKeyID int) (models.EggPrice, error) {
+	const query = `INSERT INTO egg_prices (date, location, price_per_dozen, source, created_by_key_id)
+			 VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	err := d.QueryRow(query, p.Date, p.Location, p.PricePerDozen, p.Source, nullableKeyID(createdByKeyID)).Scan(&p.ID)
+	return p, err
+}
+
+// GetLocations returns every tracked location, alphabetically.
+func (d *DB) GetLocations() ([]models.Location, error) {
+	rows, err := d.Query("SELECT id, name, type FROM locations ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []models.Location
+	for rows.Next() {
+		var l models.Location
+		if err := rows.Scan(&l.ID, &l.Name, &l.Type); err != nil {
+			return nil, err
+		}
+		locations = append(locations, l)
+	}
+
+	return locations, rows.Err()
+}
+
+// GetComparison returns each non-national zone's latest price alongside the
+// latest price for the zone of type models.ZoneNational, joining through the
+// zones table rather than matching on a hardcoded location string so the
+// zone model stays the single source of truth for what counts as national.
+func (d *DB) GetComparison() ([]models.PriceComparison, error) {
+	const query = `
+		WITH latest_prices AS (
+			SELECT DISTINCT ON (ep.location) z.code, z.type, ep.price_per_dozen, ep.date
+			FROM egg_prices ep
+			JOIN zones z ON z.code = ep.location
+			ORDER BY ep.location, ep.date DESC
+		)
+		SELECT
+			lp.code,
+			lp.price_per_dozen,
+			np.price_per_dozen as national_price
+		FROM latest_prices lp
+		CROSS JOIN (
+			SELECT price_per_dozen
+			FROM latest_prices
+			WHERE type = $1
+		) np
+		WHERE lp.type != $1
+		ORDER BY lp.code`
+
+	rows, err := d.Query(query, models.ZoneNational)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comparisons []models.PriceComparison
+	for rows.Next() {
+		var c models.PriceComparison
+		if err := rows.Scan(&c.Location, &c.CurrentPrice, &c.NationalPrice); err != nil {
+			return nil, err
+		}
+
+		c.Difference = c.CurrentPrice - c.NationalPrice
+		if c.NationalPrice > 0 {
+			c.Percentage = (c.Difference / c.NationalPrice) * 100
+		}
+
+		comparisons = append(comparisons, c)
+	}
+
+	return comparisons, rows.Err()
+}
+
+// UpsertFetchedPrices inserts each fetched price, relying on the
+// idx_egg_prices_source_dedup partial unique index (0002_add_remote_id.sql)
+// to skip any row that already has a matching source+date+location+remote_id.
+// Using ON CONFLICT DO NOTHING rather than a NOT EXISTS precheck means a
+// concurrent insert racing on the same remote_id loses the conflict instead
+// of erroring the whole batch out. A fetched price whose location doesn't
+// match a known zone code is skipped rather than inserted, since sources are
+// free-text and can't be trusted to only ever emit seeded zone codes.
+func (d *DB) UpsertFetchedPrices(ctx context.Context, prices []scheduler.FetchedPrice) (inserted, skipped int, err error) {
+	const query = `
+		INSERT INTO egg_prices (date, location, price_per_dozen, source, remote_id)
+		SELECT $1, $2, $3, $4, $5
+		WHERE EXISTS (SELECT 1 FROM zones WHERE code = $2)
+		ON CONFLICT (source, date, location, remote_id) WHERE remote_id IS NOT NULL
+		DO NOTHING`
+
+	for _, p := range prices {
+		res, execErr := d.ExecContext(ctx, query, p.Date, p.Location, p.PricePerDozen, p.Source, p.RemoteID)
+		if execErr != nil {
+			return inserted, skipped, fmt.Errorf("upserting price: %w", execErr)
+		}
+
+		n, _ := res.RowsAffected()
+		if n > 0 {
+			inserted++
+		} else {
+			skipped++
+		}
+	}
+
+	return inserted, skipped, nil
+}
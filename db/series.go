@@ -0,0 +1,46 @@
+package db
+
+import "github.com/11522514/egg-price-tracker/models"
+
+// granularityUnits maps the API's granularity values to the date_trunc unit
+// that produces them.
+var granularityUnits = map[string]string{
+	"daily":   "day",
+	"weekly":  "week",
+	"monthly": "month",
+}
+
+// GetPriceSeries returns the min/max/avg price per bucket for a zone between
+// from and to (inclusive, "YYYY-MM-DD"), bucketed at the given granularity.
+func (d *DB) GetPriceSeries(zoneCode, from, to, granularity string) ([]models.PriceBucket, error) {
+	unit, ok := granularityUnits[granularity]
+	if !ok {
+		unit = "day"
+	}
+
+	const query = `
+		SELECT date_trunc($1, ep.date) AS bucket,
+			MIN(ep.price_per_dozen), MAX(ep.price_per_dozen), AVG(ep.price_per_dozen)
+		FROM egg_prices ep
+		JOIN zones z ON z.code = ep.location
+		WHERE z.code = $2 AND ep.date >= $3 AND ep.date <= $4
+		GROUP BY bucket
+		ORDER BY bucket`
+
+	rows, err := d.Query(query, unit, zoneCode, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []models.PriceBucket
+	for rows.Next() {
+		var b models.PriceBucket
+		if err := rows.Scan(&b.Bucket, &b.Min, &b.Max, &b.Avg); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, b)
+	}
+
+	return buckets, rows.Err()
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/11522514/egg-price-tracker/models"
+)
+
+// ZonesRepository looks up and creates the zones prices can be queried
+// against.
+type ZonesRepository interface {
+	GetByCode(code string) (*models.Zone, error)
+	ListZones() ([]models.Zone, error)
+	CreateZone(code, name, zoneType string) (*models.Zone, error)
+}
+
+var _ ZonesRepository = (*DB)(nil)
+
+// validZoneTypes are the Zone.Type values the API accepts.
+var validZoneTypes = map[string]bool{
+	models.ZoneNational: true,
+	models.ZoneState:    true,
+	models.ZoneMetro:    true,
+	models.ZoneStore:    true,
+}
+
+// GetByCode returns the zone with the given code, or nil if none exists.
+func (d *DB) GetByCode(code string) (*models.Zone, error) {
+	var z models.Zone
+	err := d.QueryRow(`SELECT id, code, name, type FROM zones WHERE code = $1`, code).
+		Scan(&z.ID, &z.Code, &z.Name, &z.Type)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// ListZones returns every zone, alphabetically by name.
+func (d *DB) ListZones() ([]models.Zone, error) {
+	rows, err := d.Query("SELECT id, code, name, type FROM zones ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var zones []models.Zone
+	for rows.Next() {
+		var z models.Zone
+		if err := rows.Scan(&z.ID, &z.Code, &z.Name, &z.Type); err != nil {
+			return nil, err
+		}
+		zones = append(zones, z)
+	}
+
+	return zones, rows.Err()
+}
+
+// CreateZone creates a new zone that prices can then be filed and queried
+// against via its code. zoneType must be one of the known Zone* constants.
+func (d *DB) CreateZone(code, name, zoneType string) (*models.Zone, error) {
+	if !validZoneTypes[zoneType] {
+		return nil, fmt.Errorf("invalid zone type %q", zoneType)
+	}
+
+	var z models.Zone
+	err := d.QueryRow(
+		`INSERT INTO zones (code, name, type) VALUES ($1, $2, $3) RETURNING id, code, name, type`,
+		code, name, zoneType,
+	).Scan(&z.ID, &z.Code, &z.Name, &z.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
@@ -0,0 +1,176 @@
+// Package forecast projects future values of a price series using Holt-Winters
+// triple exponential smoothing, falling back to simple exponential smoothing
+// when the series is too short to fit a seasonal model.
+package forecast
+
+import (
+	"fmt"
+	"math"
+)
+
+// Params are the smoothing parameters a model was fit with. Gamma is zero
+// for the simple-exponential-smoothing fallback, which has no seasonal term.
+type Params struct {
+	Alpha float64 `json:"alpha"`
+	Beta  float64 `json:"beta,omitempty"`
+	Gamma float64 `json:"gamma,omitempty"`
+}
+
+// Point is a single forecasted period: a point estimate plus a ±1.96·σ
+// residual band.
+type Point struct {
+	Horizon int     `json:"horizon"`
+	Value   float64 `json:"value"`
+	Lower   float64 `json:"lower"`
+	Upper   float64 `json:"upper"`
+}
+
+// Result is the output of Forecast.
+type Result struct {
+	Method string  `json:"method"`
+	Params Params  `json:"params"`
+	Points []Point `json:"points"`
+}
+
+// grid is the (0,1)-exclusive set of smoothing parameter candidates searched
+// when fitting a model.
+var grid = []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+
+// Forecast fits a Holt-Winters model to y (oldest first) and projects
+// horizon periods ahead, using season length m (default 12, monthly data).
+// Fitting a seasonal model requires at least 2*m points; if y is shorter,
+// Forecast returns an error unless auto is true, in which case it falls back
+// to simple exponential smoothing.
+func Forecast(y []float64, horizon, m int, auto bool) (Result, error) {
+	if horizon <= 0 {
+		return Result{}, fmt.Errorf("forecast: horizon must be positive")
+	}
+	if m <= 0 {
+		m = 12
+	}
+
+	if len(y) < 2*m {
+		if !auto {
+			return Result{}, fmt.Errorf("forecast: need at least %d data points for a seasonal model, got %d", 2*m, len(y))
+		}
+		return simpleExponentialSmoothing(y, horizon)
+	}
+
+	return holtWinters(y, horizon, m)
+}
+
+// holtWinters fits level, trend and seasonal components by grid search over
+// alpha, beta, gamma, minimizing in-sample MSE, then projects horizon
+// periods ahead from the fitted state.
+func holtWinters(y []float64, horizon, m int) (Result, error) {
+	best := Params{}
+	bestMSE := math.Inf(1)
+	var bestLevel, bestTrend float64
+	var bestSeason []float64
+
+	for _, alpha := range grid {
+		for _, beta := range grid {
+			for _, gamma := range grid {
+				level, trend, season, residuals := fitHoltWinters(y, alpha, beta, gamma, m)
+				mse := meanSquare(residuals)
+				if mse < bestMSE {
+					bestMSE = mse
+					best = Params{Alpha: alpha, Beta: beta, Gamma: gamma}
+					bestLevel, bestTrend, bestSeason = level, trend, season
+				}
+			}
+		}
+	}
+
+	sigma := math.Sqrt(bestMSE)
+	points := make([]Point, horizon)
+	for h := 1; h <= horizon; h++ {
+		seasonIdx := (len(y) - m + ((h-1)%m + m)%m) % m
+		value := bestLevel + float64(h)*bestTrend + bestSeason[seasonIdx]
+		points[h-1] = Point{Horizon: h, Value: value, Lower: value - 1.96*sigma, Upper: value + 1.96*sigma}
+	}
+
+	return Result{Method: "holt-winters", Params: best, Points: points}, nil
+}
+
+// fitHoltWinters runs the level/trend/seasonal recurrences for one
+// (alpha, beta, gamma) and returns the final state plus the in-sample
+// one-step-ahead residuals used to score the fit.
+func fitHoltWinters(y []float64, alpha, beta, gamma float64, m int) (level, trend float64, season, residuals []float64) {
+	level = mean(y[:m])
+	trend = (mean(y[m:2*m]) - mean(y[:m])) / float64(m)
+
+	season = make([]float64, m)
+	for i := 0; i < m; i++ {
+		season[i] = y[i] - level
+	}
+
+	residuals = make([]float64, 0, len(y)-m)
+	for i := m; i < len(y); i++ {
+		idx := i % m
+		prevLevel, prevTrend := level, trend
+
+		fitted := prevLevel + prevTrend + season[idx]
+		residuals = append(residuals, y[i]-fitted)
+
+		level = alpha*(y[i]-season[idx]) + (1-alpha)*(prevLevel+prevTrend)
+		trend = beta*(level-prevLevel) + (1-beta)*prevTrend
+		season[idx] = gamma*(y[i]-level) + (1-gamma)*season[idx]
+	}
+
+	return level, trend, season, residuals
+}
+
+// simpleExponentialSmoothing fits a level-only model by grid search over
+// alpha, for series too short to support a seasonal fit.
+func simpleExponentialSmoothing(y []float64, horizon int) (Result, error) {
+	if len(y) < 2 {
+		return Result{}, fmt.Errorf("forecast: need at least 2 data points, got %d", len(y))
+	}
+
+	bestAlpha := grid[0]
+	bestMSE := math.Inf(1)
+	var bestLevel float64
+
+	for _, alpha := range grid {
+		level := y[0]
+		residuals := make([]float64, 0, len(y)-1)
+		for i := 1; i < len(y); i++ {
+			residuals = append(residuals, y[i]-level)
+			level = alpha*y[i] + (1-alpha)*level
+		}
+		mse := meanSquare(residuals)
+		if mse < bestMSE {
+			bestMSE = mse
+			bestAlpha = alpha
+			bestLevel = level
+		}
+	}
+
+	sigma := math.Sqrt(bestMSE)
+	points := make([]Point, horizon)
+	for h := 1; h <= horizon; h++ {
+		points[h-1] = Point{Horizon: h, Value: bestLevel, Lower: bestLevel - 1.96*sigma, Upper: bestLevel + 1.96*sigma}
+	}
+
+	return Result{Method: "simple-exponential-smoothing", Params: Params{Alpha: bestAlpha}, Points: points}, nil
+}
+
+func mean(xs []float64) float64 {
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+func meanSquare(xs []float64) float64 {
+	if len(xs) == 0 {
+		return math.Inf(1)
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += x * x
+	}
+	return sum / float64(len(xs))
+}
@@ -0,0 +1,124 @@
+package forecast
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// TestFitHoltWinters checks the level/trend/seasonal recurrence against a
+// hand-computed run: y = [10, 20, 12, 22, 14, 24], m = 2,
+// alpha = beta = gamma = 0.5.
+func TestFitHoltWinters(t *testing.T) {
+	y := []float64{10, 20, 12, 22, 14, 24}
+
+	level, trend, season, residuals := fitHoltWinters(y, 0.5, 0.5, 0.5, 2)
+
+	wantLevel := 19.4609375
+	wantTrend := 1.00390625
+	wantSeason := []float64{-4.671875, 4.67578125}
+	wantResiduals := []float64{1.0, -0.75, 0.3125, -0.546875}
+
+	if !approxEqual(level, wantLevel) {
+		t.Errorf("level = %v, want %v", level, wantLevel)
+	}
+	if !approxEqual(trend, wantTrend) {
+		t.Errorf("trend = %v, want %v", trend, wantTrend)
+	}
+	if len(season) != len(wantSeason) {
+		t.Fatalf("season length = %d, want %d", len(season), len(wantSeason))
+	}
+	for i := range season {
+		if !approxEqual(season[i], wantSeason[i]) {
+			t.Errorf("season[%d] = %v, want %v", i, season[i], wantSeason[i])
+		}
+	}
+	if len(residuals) != len(wantResiduals) {
+		t.Fatalf("residuals length = %d, want %d", len(residuals), len(wantResiduals))
+	}
+	for i := range residuals {
+		if !approxEqual(residuals[i], wantResiduals[i]) {
+			t.Errorf("residuals[%d] = %v, want %v", i, residuals[i], wantResiduals[i])
+		}
+	}
+}
+
+// TestFitHoltWintersFlatSeries checks that a perfectly flat series (no
+// trend, no seasonality) fits with zero residuals regardless of the
+// smoothing parameters chosen.
+func TestFitHoltWintersFlatSeries(t *testing.T) {
+	y := make([]float64, 8)
+	for i := range y {
+		y[i] = 5
+	}
+
+	level, trend, season, residuals := fitHoltWinters(y, 0.3, 0.3, 0.3, 4)
+
+	if !approxEqual(level, 5) {
+		t.Errorf("level = %v, want 5", level)
+	}
+	if !approxEqual(trend, 0) {
+		t.Errorf("trend = %v, want 0", trend)
+	}
+	for i, s := range season {
+		if !approxEqual(s, 0) {
+			t.Errorf("season[%d] = %v, want 0", i, s)
+		}
+	}
+	for i, r := range residuals {
+		if !approxEqual(r, 0) {
+			t.Errorf("residuals[%d] = %v, want 0", i, r)
+		}
+	}
+}
+
+func TestForecastRejectsNonPositiveHorizon(t *testing.T) {
+	if _, err := Forecast([]float64{1, 2, 3}, 0, 2, true); err == nil {
+		t.Fatal("expected an error for a zero horizon, got nil")
+	}
+}
+
+func TestForecastRequiresTwoSeasonsUnlessAuto(t *testing.T) {
+	y := []float64{10, 20, 12, 22} // 4 points, m=12 needs 24
+
+	if _, err := Forecast(y, 3, 12, false); err == nil {
+		t.Fatal("expected an error for a short series with auto=false, got nil")
+	}
+
+	result, err := Forecast(y, 3, 12, true)
+	if err != nil {
+		t.Fatalf("Forecast with auto=true returned an error: %v", err)
+	}
+	if result.Method != "simple-exponential-smoothing" {
+		t.Errorf("Method = %q, want simple-exponential-smoothing", result.Method)
+	}
+	if len(result.Points) != 3 {
+		t.Fatalf("len(Points) = %d, want 3", len(result.Points))
+	}
+}
+
+func TestForecastHoltWinters(t *testing.T) {
+	y := make([]float64, 24)
+	for i := range y {
+		y[i] = 10 + float64(i%2)*2
+	}
+
+	result, err := Forecast(y, 2, 2, false)
+	if err != nil {
+		t.Fatalf("Forecast returned an error: %v", err)
+	}
+	if result.Method != "holt-winters" {
+		t.Errorf("Method = %q, want holt-winters", result.Method)
+	}
+	if len(result.Points) != 2 {
+		t.Fatalf("len(Points) = %d, want 2", len(result.Points))
+	}
+	for _, p := range result.Points {
+		if p.Lower > p.Value || p.Value > p.Upper {
+			t.Errorf("point %+v does not satisfy Lower <= Value <= Upper", p)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/11522514/egg-price-tracker/models"
+)
+
+// ExportPrices streams every stored price back as CSV or JSON, without
+// buffering the full result set in memory.
+func (s *Server) ExportPrices(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	switch format {
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="egg_prices.csv"`)
+
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"date", "location", "price_per_dozen", "source"})
+
+		err := s.DB.StreamPrices(r.Context(), func(p models.EggPrice) error {
+			return cw.Write([]string{p.Date, p.Location, strconv.FormatFloat(p.PricePerDozen, 'f', 2, 64), p.Source})
+		})
+		cw.Flush()
+		if err != nil {
+			// The 200 status and part of the CSV body are already on the
+			// wire by the time a DB error surfaces here, so an http.Error
+			// call would just append a second, unrelated error string onto
+			// an already-served response. Log it and abort the connection
+			// instead so callers see a truncated body rather than a
+			// malformed "complete" one.
+			log.Printf("export: csv stream failed: %v", err)
+			panic(http.ErrAbortHandler)
+		}
+
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+
+		first := true
+		w.Write([]byte("["))
+		err := s.DB.StreamPrices(r.Context(), func(p models.EggPrice) error {
+			if !first {
+				w.Write([]byte(","))
+			}
+			first = false
+			return json.NewEncoder(w).Encode(p)
+		})
+		w.Write([]byte("]"))
+		if err != nil {
+			log.Printf("export: json stream failed: %v", err)
+			panic(http.ErrAbortHandler)
+		}
+
+	default:
+		http.Error(w, "Invalid format parameter", http.StatusBadRequest)
+	}
+}
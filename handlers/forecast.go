@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/11522514/egg-price-tracker/forecast"
+)
+
+// seasonLength is the Holt-Winters season length (m), monthly data.
+const seasonLength = 12
+
+// GetForecast returns a Holt-Winters price forecast for a location. With
+// mode=auto, series too short for a seasonal fit fall back to simple
+// exponential smoothing instead of erroring.
+func (s *Server) GetForecast(w http.ResponseWriter, r *http.Request) {
+	location := r.URL.Query().Get("location")
+	if location == "" {
+		http.Error(w, "Missing location parameter", http.StatusBadRequest)
+		return
+	}
+
+	horizon, err := strconv.Atoi(r.URL.Query().Get("horizon"))
+	if err != nil || horizon <= 0 {
+		http.Error(w, "Invalid horizon parameter", http.StatusBadRequest)
+		return
+	}
+
+	auto := r.URL.Query().Get("mode") == "auto"
+
+	series, err := s.DB.GetPriceHistory(location)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result, err := forecast.Forecast(series, horizon, seasonLength, auto)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
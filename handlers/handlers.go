@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/11522514/egg-price-tracker/auth"
+	"github.com/11522514/egg-price-tracker/db"
+	"github.com/11522514/egg-price-tracker/models"
+	"github.com/11522514/egg-price-tracker/scheduler"
+)
+
+// Server holds the shared dependencies HTTP handlers need, threaded through
+// instead of relying on package-level globals.
+type Server struct {
+	DB          *db.DB
+	Sources     *scheduler.Registry
+	RateLimiter *auth.RateLimiter
+}
+
+// NewServer builds a Server from its dependencies.
+func NewServer(database *db.DB, sources *scheduler.Registry, rateLimiter *auth.RateLimiter) *Server {
+	return &Server{DB: database, Sources: sources, RateLimiter: rateLimiter}
+}
+
+// GetPrices returns an aggregated price series for a zone, bucketed by
+// granularity between from and to.
+func (s *Server) GetPrices(w http.ResponseWriter, r *http.Request) {
+	zoneCode := r.URL.Query().Get("zone")
+	if zoneCode == "" {
+		zoneCode = "NATIONAL"
+	}
+
+	zone, err := s.DB.GetByCode(zoneCode)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if zone == nil {
+		http.Error(w, "Unknown zone", http.StatusNotFound)
+		return
+	}
+
+	from := r.URL.Query().Get("from")
+	if from == "" {
+		from = "1970-01-01"
+	}
+	to := r.URL.Query().Get("to")
+	if to == "" {
+		to = time.Now().Format("2006-01-02")
+	}
+
+	granularity := r.URL.Query().Get("granularity")
+	if granularity == "" {
+		granularity = "daily"
+	}
+	switch granularity {
+	case "daily", "weekly", "monthly":
+	default:
+		http.Error(w, "Invalid granularity parameter", http.StatusBadRequest)
+		return
+	}
+
+	series, err := s.DB.GetPriceSeries(zone.Code, from, to, granularity)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(series)
+}
+
+func (s *Server) GetZones(w http.ResponseWriter, r *http.Request) {
+	zones, err := s.DB.ListZones()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(zones)
+}
+
+// CreateZone creates a new zone of any known type (national, state, metro,
+// or store) that prices can then be filed and queried against via its code.
+func (s *Server) CreateZone(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var zone models.Zone
+	if err := json.NewDecoder(r.Body).Decode(&zone); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if zone.Code == "" || zone.Name == "" || zone.Type == "" {
+		http.Error(w, "code, name and type are required", http.StatusBadRequest)
+		return
+	}
+
+	created, err := s.DB.CreateZone(zone.Code, zone.Name, zone.Type)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(created)
+}
+
+func (s *Server) AddPrice(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var price models.EggPrice
+	if err := json.NewDecoder(r.Body).Decode(&price); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	zone, err := s.DB.GetByCode(price.Location)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if zone == nil {
+		http.Error(w, "Unknown zone", http.StatusBadRequest)
+		return
+	}
+
+	price, err = s.DB.AddPrice(price, auth.KeyIDFromContext(r.Context()))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(price)
+}
+
+func (s *Server) GetLocations(w http.ResponseWriter, r *http.Request) {
+	locations, err := s.DB.GetLocations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(locations)
+}
+
+func (s *Server) GetComparison(w http.ResponseWriter, r *http.Request) {
+	comparisons, err := s.DB.GetComparison()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(comparisons)
+}
+
+func (s *Server) ListSources(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.Sources.List())
+}
+
+func (s *Server) RunSource(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := mux.Vars(r)["id"]
+
+	sched := scheduler.New(s.Sources, s.DB.UpsertFetchedPrices, 0)
+	inserted, skipped, err := sched.RunOne(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int{
+		"inserted": inserted,
+		"skipped":  skipped,
+	})
+}
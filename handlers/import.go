@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/11522514/egg-price-tracker/auth"
+	"github.com/11522514/egg-price-tracker/models"
+)
+
+// maxImportSize caps the total request body accepted by ImportPrices (via
+// http.MaxBytesReader) and the in-memory threshold ParseMultipartForm uses
+// before spilling form parts to temp files.
+const maxImportSize = 32 << 20 // 32MB
+
+var wantColumns = []string{"date", "location", "price_per_dozen", "source"}
+
+// ImportPrices accepts a CSV or XLSX upload with columns
+// date,location,price_per_dozen,source and streams the rows into the DB
+// inside a transaction, returning a per-row inserted/skipped/error summary.
+func (s *Server) ImportPrices(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+	if err := r.ParseMultipartForm(maxImportSize); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Missing file field", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	var records [][]string
+	switch ext := strings.ToLower(filepath.Ext(header.Filename)); ext {
+	case ".csv", "":
+		records, err = readCSVRecords(file)
+	case ".xlsx":
+		records, err = readXLSXRecords(file)
+	default:
+		http.Error(w, fmt.Sprintf("Unsupported file extension %q", ext), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rows, parseErrors, err := parsePriceRows(records)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := s.DB.ImportPrices(r.Context(), rows, auth.KeyIDFromContext(r.Context()))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	result.Errors = append(parseErrors, result.Errors...)
+	result.Skipped += len(parseErrors)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+func readCSVRecords(r io.Reader) ([][]string, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	return cr.ReadAll()
+}
+
+func readXLSXRecords(r io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading xlsx: %w", err)
+	}
+	defer f.Close()
+
+	sheet := f.GetSheetName(0)
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("reading xlsx sheet: %w", err)
+	}
+	return rows, nil
+}
+
+// parsePriceRows turns raw records (header + data rows) into validated
+// ImportRows, each tagged with its original file row number, returning a
+// per-row error for anything that fails validation instead of aborting the
+// whole import.
+func parsePriceRows(records [][]string) ([]models.ImportRow, []string, error) {
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("empty file")
+	}
+
+	colIndex := make(map[string]int, len(wantColumns))
+	for i, name := range records[0] {
+		colIndex[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+	for _, name := range wantColumns {
+		if _, ok := colIndex[name]; !ok {
+			return nil, nil, fmt.Errorf("missing required column %q", name)
+		}
+	}
+
+	var rows []models.ImportRow
+	var errs []string
+
+	for i, record := range records[1:] {
+		rowNum := i + 2 // header is row 1
+
+		// excelize.GetRows trims trailing empty cells per row, so a record can
+		// come back shorter than the header whenever its last populated column
+		// is blank; guard every lookup instead of indexing blind.
+		minLen := 0
+		for _, name := range wantColumns {
+			if idx := colIndex[name]; idx+1 > minLen {
+				minLen = idx + 1
+			}
+		}
+		if len(record) < minLen {
+			errs = append(errs, fmt.Sprintf("row %d: expected %d columns, got %d", rowNum, minLen, len(record)))
+			continue
+		}
+
+		date := strings.TrimSpace(record[colIndex["date"]])
+		location := strings.TrimSpace(record[colIndex["location"]])
+		priceStr := strings.TrimSpace(record[colIndex["price_per_dozen"]])
+		source := strings.TrimSpace(record[colIndex["source"]])
+
+		if date == "" || location == "" || source == "" {
+			errs = append(errs, fmt.Sprintf("row %d: date, location and source are required", rowNum))
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			errs = append(errs, fmt.Sprintf("row %d: invalid date %q", rowNum, date))
+			continue
+		}
+		price, err := strconv.ParseFloat(priceStr, 64)
+		if err != nil || price <= 0 {
+			errs = append(errs, fmt.Sprintf("row %d: invalid price_per_dozen %q", rowNum, priceStr))
+			continue
+		}
+
+		rows = append(rows, models.ImportRow{
+			EggPrice: models.EggPrice{
+				Date:          date,
+				Location:      location,
+				PricePerDozen: price,
+				Source:        source,
+			},
+			RowNum: rowNum,
+		})
+	}
+
+	return rows, errs, nil
+}
@@ -0,0 +1,45 @@
+package models
+
+// EggPrice is a single price-per-dozen observation for a location on a date.
+type EggPrice struct {
+	ID            int     `json:"id"`
+	Date          string  `json:"date"`
+	Location      string  `json:"location"`
+	PricePerDozen float64 `json:"price_per_dozen"`
+	Source        string  `json:"source"`
+	RemoteID      string  `json:"remote_id,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+// Location is a place egg prices are tracked for (state, metro, store, ...).
+type Location struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// PriceComparison compares a location's latest price against the national
+// average.
+type PriceComparison struct {
+	Location      string  `json:"location"`
+	CurrentPrice  float64 `json:"current_price"`
+	NationalPrice float64 `json:"national_price"`
+	Difference    float64 `json:"difference"`
+	Percentage    float64 `json:"percentage"`
+}
+
+// ImportResult summarizes a bulk price import: how many rows made it in,
+// how many were skipped, and why.
+type ImportResult struct {
+	Inserted int      `json:"inserted"`
+	Skipped  int      `json:"skipped"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// ImportRow is a validated price parsed from an import file, tagged with its
+// original file row number (1-based, with the header as row 1) so later failures can
+// still be reported against the row the user needs to fix.
+type ImportRow struct {
+	EggPrice
+	RowNum int
+}
@@ -0,0 +1,28 @@
+package models
+
+// Zone types, from broadest to narrowest.
+const (
+	ZoneNational = "national"
+	ZoneState    = "state"
+	ZoneMetro    = "metro"
+	ZoneStore    = "store"
+)
+
+// Zone is a place egg prices can be queried against, identified by a stable
+// Code (e.g. "NATIONAL", "US-CA", "CHICAGO-METRO") rather than a free-text
+// location string.
+type Zone struct {
+	ID   int    `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// PriceBucket is one point in an aggregated price series: the min, max and
+// average price observed within the bucket's time span.
+type PriceBucket struct {
+	Bucket string  `json:"bucket"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Avg    float64 `json:"avg"`
+}
@@ -0,0 +1,42 @@
+package router
+
+import (
+	"net/http"
+
+	corsware "github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+
+	"github.com/11522514/egg-price-tracker/auth"
+	"github.com/11522514/egg-price-tracker/handlers"
+)
+
+// New builds the top-level HTTP handler: the API routes under /api (with
+// write endpoints behind API-key auth and per-key rate limiting), the
+// static file server, and the CORS wrapper restricted to allowedOrigins.
+func New(s *handlers.Server, allowedOrigins []string) http.Handler {
+	r := mux.NewRouter()
+
+	api := r.PathPrefix("/api").Subrouter()
+	api.HandleFunc("/prices", s.GetPrices).Methods("GET")
+	api.HandleFunc("/prices/export", s.ExportPrices).Methods("GET")
+	api.HandleFunc("/locations", s.GetLocations).Methods("GET")
+	api.HandleFunc("/zones", s.GetZones).Methods("GET")
+	api.HandleFunc("/comparison", s.GetComparison).Methods("GET")
+	api.HandleFunc("/forecast", s.GetForecast).Methods("GET")
+	api.HandleFunc("/sources", s.ListSources).Methods("GET")
+
+	writes := api.PathPrefix("").Subrouter()
+	writes.Use(auth.RequireAPIKey(s.DB), s.RateLimiter.Middleware)
+	writes.HandleFunc("/prices", s.AddPrice).Methods("POST")
+	writes.HandleFunc("/zones", s.CreateZone).Methods("POST")
+	writes.HandleFunc("/prices/import", s.ImportPrices).Methods("POST")
+	writes.HandleFunc("/sources/{id}/run", s.RunSource).Methods("POST")
+
+	r.PathPrefix("/").Handler(http.FileServer(http.Dir("./static/")))
+
+	return corsware.CORS(
+		corsware.AllowedOrigins(allowedOrigins),
+		corsware.AllowedMethods([]string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
+		corsware.AllowedHeaders([]string{"Authorization", "Content-Type"}),
+	)(r)
+}
@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// SourceConfig describes one configured PriceSource entry in sources.json.
+type SourceConfig struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	URL     string `json:"url,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Registry holds the set of enabled PriceSources, keyed by ID.
+type Registry struct {
+	sources map[string]PriceSource
+}
+
+// NewRegistry builds a Registry from a sources config file. A missing file is
+// treated as an empty registry so the server still starts with no sources
+// configured. Unknown source types and disabled entries are skipped rather
+// than erroring, so operators can stage new sources in the config before
+// flipping them on.
+func NewRegistry(configPath string) (*Registry, error) {
+	reg := &Registry{sources: make(map[string]PriceSource)}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return reg, nil
+		}
+		return nil, fmt.Errorf("scheduler: reading source config: %w", err)
+	}
+
+	var configs []SourceConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("scheduler: parsing source config: %w", err)
+	}
+
+	for _, c := range configs {
+		if !c.Enabled {
+			continue
+		}
+		switch c.Type {
+		case "usda":
+			reg.sources[c.ID] = NewUSDASource(c.ID, c.URL)
+		default:
+			continue
+		}
+	}
+
+	return reg, nil
+}
+
+// Get returns the source registered under id, or false if it isn't enabled.
+func (r *Registry) Get(id string) (PriceSource, bool) {
+	s, ok := r.sources[id]
+	return s, ok
+}
+
+// List returns the ids of all enabled sources.
+func (r *Registry) List() []string {
+	ids := make([]string, 0, len(r.sources))
+	for id := range r.sources {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// UpsertFunc persists fetched prices, skipping rows that already exist for
+// the same source+date+location+remote_id.
+type UpsertFunc func(ctx context.Context, prices []FetchedPrice) (inserted, skipped int, err error)
+
+// Scheduler periodically runs every enabled PriceSource on a fixed interval
+// and upserts whatever it returns.
+type Scheduler struct {
+	registry *Registry
+	upsert   UpsertFunc
+	interval time.Duration
+}
+
+// New builds a Scheduler that polls every source in registry once per
+// interval, persisting results via upsert.
+func New(registry *Registry, upsert UpsertFunc, interval time.Duration) *Scheduler {
+	return &Scheduler{registry: registry, upsert: upsert, interval: interval}
+}
+
+// Start runs the scheduler loop until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunAll(ctx)
+		}
+	}
+}
+
+// RunAll fetches from every enabled source and upserts the results,
+// logging (rather than aborting) on a per-source failure.
+func (s *Scheduler) RunAll(ctx context.Context) {
+	for _, id := range s.registry.List() {
+		if _, _, err := s.RunOne(ctx, id); err != nil {
+			log.Printf("scheduler: source %s failed: %v", id, err)
+		}
+	}
+}
+
+// RunOne fetches and upserts from a single named source.
+func (s *Scheduler) RunOne(ctx context.Context, id string) (inserted, skipped int, err error) {
+	source, ok := s.registry.Get(id)
+	if !ok {
+		return 0, 0, fmt.Errorf("scheduler: unknown source %q", id)
+	}
+
+	prices, err := source.Fetch(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return s.upsert(ctx, prices)
+}
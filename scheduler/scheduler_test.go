@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type fakeSource struct {
+	id     string
+	prices []FetchedPrice
+	err    error
+}
+
+func (f fakeSource) ID() string { return f.id }
+
+func (f fakeSource) Fetch(ctx context.Context) ([]FetchedPrice, error) {
+	return f.prices, f.err
+}
+
+func newTestRegistry(sources ...PriceSource) *Registry {
+	reg := &Registry{sources: make(map[string]PriceSource, len(sources))}
+	for _, s := range sources {
+		reg.sources[s.ID()] = s
+	}
+	return reg
+}
+
+func TestRunOneUpsertsFetchedPrices(t *testing.T) {
+	price := FetchedPrice{Date: "2026-01-01", Location: "NATIONAL", PricePerDozen: 3.5, Source: "usda", RemoteID: "r1"}
+	reg := newTestRegistry(fakeSource{id: "usda", prices: []FetchedPrice{price}})
+
+	var got []FetchedPrice
+	upsert := func(ctx context.Context, prices []FetchedPrice) (int, int, error) {
+		got = prices
+		return 1, 0, nil
+	}
+
+	sched := New(reg, upsert, 0)
+	inserted, skipped, err := sched.RunOne(context.Background(), "usda")
+	if err != nil {
+		t.Fatalf("RunOne returned an error: %v", err)
+	}
+	if inserted != 1 || skipped != 0 {
+		t.Errorf("RunOne = (%d, %d), want (1, 0)", inserted, skipped)
+	}
+	if len(got) != 1 || got[0] != price {
+		t.Errorf("upsert received %+v, want [%+v]", got, price)
+	}
+}
+
+func TestRunOneUnknownSource(t *testing.T) {
+	reg := newTestRegistry()
+	sched := New(reg, func(ctx context.Context, prices []FetchedPrice) (int, int, error) {
+		t.Fatal("upsert should not be called for an unknown source")
+		return 0, 0, nil
+	}, 0)
+
+	if _, _, err := sched.RunOne(context.Background(), "missing"); err == nil {
+		t.Fatal("expected an error for an unknown source, got nil")
+	}
+}
+
+// TestRunAllContinuesPastAPerSourceFailure ensures one source's fetch error
+// doesn't stop the others in the same batch from running.
+func TestRunAllContinuesPastAPerSourceFailure(t *testing.T) {
+	reg := newTestRegistry(
+		fakeSource{id: "broken", err: fmt.Errorf("network error")},
+		fakeSource{id: "ok", prices: []FetchedPrice{{Source: "ok", RemoteID: "r1"}}},
+	)
+
+	ran := make(map[string]bool)
+	upsert := func(ctx context.Context, prices []FetchedPrice) (int, int, error) {
+		for _, p := range prices {
+			ran[p.Source] = true
+		}
+		return len(prices), 0, nil
+	}
+
+	sched := New(reg, upsert, 0)
+	sched.RunAll(context.Background())
+
+	if !ran["ok"] {
+		t.Error("expected the healthy source to run despite the other source's fetch error")
+	}
+}
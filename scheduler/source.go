@@ -0,0 +1,24 @@
+package scheduler
+
+import "context"
+
+// FetchedPrice is a single price point returned by a PriceSource, prior to
+// being upserted into the egg_prices table.
+type FetchedPrice struct {
+	Date          string
+	Location      string
+	PricePerDozen float64
+	Source        string
+	RemoteID      string
+}
+
+// PriceSource fetches egg price data from an external provider (a statistics
+// agency, a retailer API, a scraper, etc). Implementations should be safe to
+// call repeatedly on a schedule; deduplication of already-seen rows happens
+// downstream via RemoteID.
+type PriceSource interface {
+	// ID uniquely identifies the source within the registry (e.g. "usda").
+	ID() string
+	// Fetch retrieves the latest available price points from the source.
+	Fetch(ctx context.Context) ([]FetchedPrice, error)
+}
@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// USDASource pulls average retail egg prices from the USDA Agricultural
+// Marketing Service "Egg Markets Overview" report API. It is the built-in
+// source enabled by default in sources.json.
+type USDASource struct {
+	id     string
+	url    string
+	client *http.Client
+}
+
+// NewUSDASource builds a USDASource. If url is empty, the public AMS "Egg
+// Markets Overview" report endpoint is used.
+func NewUSDASource(id, url string) *USDASource {
+	if url == "" {
+		url = "https://marsapi.ams.usda.gov/services/v1.2/reports/2843"
+	}
+	return &USDASource{id: id, url: url, client: http.DefaultClient}
+}
+
+func (s *USDASource) ID() string { return s.id }
+
+func (s *USDASource) Fetch(ctx context.Context) ([]FetchedPrice, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("usda: building request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("usda: fetching report: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("usda: unexpected status %d", resp.StatusCode)
+	}
+
+	var payload struct {
+		Results []struct {
+			ReportDate string  `json:"report_date"`
+			Region     string  `json:"region"`
+			AvgPrice   float64 `json:"avg_price_per_dozen"`
+			SlugID     string  `json:"slug_id"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("usda: decoding report: %w", err)
+	}
+
+	prices := make([]FetchedPrice, 0, len(payload.Results))
+	for _, r := range payload.Results {
+		prices = append(prices, FetchedPrice{
+			Date:          r.ReportDate,
+			Location:      r.Region,
+			PricePerDozen: r.AvgPrice,
+			Source:        "usda",
+			RemoteID:      r.SlugID,
+		})
+	}
+	return prices, nil
+}